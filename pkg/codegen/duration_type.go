@@ -0,0 +1,26 @@
+package codegen
+
+// DurationType represents a JSON schema string with format "duration".
+//
+// When Nullable is set (the schema marks the field nullable, or its
+// default is JSON null or an empty ISO-8601 duration), the field is
+// generated as types.OptionalDuration instead of a bare time.Duration, so
+// "unset, use the default" can be represented distinctly from a zero
+// duration.
+type DurationType struct {
+	Nullable bool
+}
+
+// Generate writes the field's Go type: types.OptionalDuration when
+// Nullable, otherwise time.Duration.
+func (t DurationType) Generate(out *Emitter) error {
+	if t.Nullable {
+		out.Printf("types.OptionalDuration")
+
+		return nil
+	}
+
+	out.Printf("time.Duration")
+
+	return nil
+}
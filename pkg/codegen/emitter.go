@@ -0,0 +1,68 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Emitter accumulates generated Go source text with simple indentation
+// tracking. It is used both for whole generated files and for small
+// throwaway snippets (e.g. formatting a single default value).
+type Emitter struct {
+	maxLineLength int32
+	indent        int
+	buf           strings.Builder
+}
+
+// NewEmitter returns an Emitter configured with maxLineLength as its line
+// width. The width is only recorded: Commentf writes each comment as a
+// single line and does not wrap it.
+func NewEmitter(maxLineLength int32) *Emitter {
+	return &Emitter{maxLineLength: maxLineLength}
+}
+
+// MaxLineLength returns the configured line width.
+func (e *Emitter) MaxLineLength() int32 {
+	return e.maxLineLength
+}
+
+// Indent adjusts the current indentation level by delta.
+func (e *Emitter) Indent(delta int) {
+	e.indent += delta
+}
+
+func (e *Emitter) writeIndent() {
+	e.buf.WriteString(strings.Repeat("\t", e.indent))
+}
+
+// Printlnf writes an indented, formatted line followed by a newline.
+func (e *Emitter) Printlnf(format string, args ...interface{}) {
+	e.writeIndent()
+	fmt.Fprintf(&e.buf, format, args...)
+	e.buf.WriteString("\n")
+}
+
+// Printf writes an indented, formatted fragment without a trailing
+// newline, so callers can keep composing the current line.
+func (e *Emitter) Printf(format string, args ...interface{}) {
+	e.writeIndent()
+	fmt.Fprintf(&e.buf, format, args...)
+}
+
+// Commentf writes a "// "-prefixed doc comment line.
+func (e *Emitter) Commentf(format string, args ...interface{}) {
+	e.writeIndent()
+	e.buf.WriteString("// ")
+	fmt.Fprintf(&e.buf, format, args...)
+	e.buf.WriteString("\n")
+}
+
+// EmptyLine writes a blank line.
+func (e *Emitter) EmptyLine() {
+	e.buf.WriteString("\n")
+}
+
+// String returns the accumulated output.
+func (e *Emitter) String() string {
+	return e.buf.String()
+}
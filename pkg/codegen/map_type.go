@@ -0,0 +1,21 @@
+package codegen
+
+// MapType represents a generated Go map type, such as one used for a
+// schema's additionalProperties.
+type MapType struct {
+	KeyType   Type
+	ValueType Type
+}
+
+// Generate writes the map's Go type, e.g. "map[string]Foo".
+func (t *MapType) Generate(out *Emitter) error {
+	out.Printf("map[")
+
+	if err := t.KeyType.Generate(out); err != nil {
+		return err
+	}
+
+	out.Printf("]")
+
+	return t.ValueType.Generate(out)
+}
@@ -0,0 +1,14 @@
+package codegen
+
+// NamedType is a reference to another generated type declaration, used
+// when a field's type is itself a named struct rather than an inline type.
+type NamedType struct {
+	Decl *TypeDecl
+}
+
+// Generate writes the referenced declaration's Go type name.
+func (t *NamedType) Generate(out *Emitter) error {
+	out.Printf("%s", t.Decl.GetName())
+
+	return nil
+}
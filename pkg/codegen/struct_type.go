@@ -0,0 +1,39 @@
+package codegen
+
+// StructType represents a generated Go struct type.
+type StructType struct {
+	Fields []StructField
+}
+
+// Generate writes the struct's inline type literal, e.g. "struct{ ... }".
+// Named struct types are normally referenced through NamedType instead;
+// this is used for anonymous/inline struct fields.
+func (t *StructType) Generate(out *Emitter) error {
+	out.Printf("struct {")
+
+	for _, f := range t.Fields {
+		out.Printf(" %s ", f.Name)
+
+		if err := f.Type.Generate(out); err != nil {
+			return err
+		}
+
+		out.Printf(";")
+	}
+
+	out.Printf(" }")
+
+	return nil
+}
+
+// StructField represents a single field of a generated struct, including
+// any default value parsed from the JSON schema.
+type StructField struct {
+	Name string
+	Type Type
+	// Required marks a field as required by the schema. When the field
+	// also has a DefaultValue, a default map for the enclosing type must
+	// supply it.
+	Required     bool
+	DefaultValue interface{}
+}
@@ -0,0 +1,9 @@
+package codegen
+
+// Type is implemented by every generated Go type representation (structs,
+// named types, durations, maps, and so on). Generate writes the type's Go
+// type expression (e.g. "time.Duration", "[]Foo") to out, without a
+// trailing newline, so callers can compose it inline with other text.
+type Type interface {
+	Generate(out *Emitter) error
+}
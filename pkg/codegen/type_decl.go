@@ -0,0 +1,13 @@
+package codegen
+
+// TypeDecl represents a named Go type declaration generated from a JSON
+// schema definition.
+type TypeDecl struct {
+	Name string
+	Type Type
+}
+
+// GetName returns the declared type's Go name.
+func (d *TypeDecl) GetName() string {
+	return d.Name
+}
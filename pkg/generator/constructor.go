@@ -11,6 +11,11 @@ import (
 	"github.com/atombender/go-jsonschema/pkg/codegen"
 )
 
+// typesImportPath is the runtime package providing OptionalDuration, which
+// generated code references whenever a nullable duration field gets a
+// default value (see formatDefaultValue).
+const typesImportPath = "github.com/atombender/go-jsonschema/pkg/types"
+
 // constructorGenerator generates New* constructor functions for struct types
 // that have fields with default values from the JSON schema.
 type constructorGenerator struct {
@@ -18,6 +23,13 @@ type constructorGenerator struct {
 	output *output
 }
 
+// fieldAssignment pairs a struct field name with its formatted default value
+// expression, shared between the New* constructor and ApplyDefaults bodies.
+type fieldAssignment struct {
+	name  string
+	value string
+}
+
 // hasDefaults returns true if the struct type has any fields with default values.
 func (g *constructorGenerator) hasDefaults() bool {
 	st, ok := g.decl.Type.(*codegen.StructType)
@@ -43,26 +55,43 @@ func (g *constructorGenerator) generate() func(*codegen.Emitter) error {
 		}
 
 		typeName := g.decl.Name
+
+		var (
+			auxDecls    []string
+			assignments []fieldAssignment
+		)
+
+		for _, f := range st.Fields {
+			if f.DefaultValue == nil || f.Name == additionalProperties {
+				continue
+			}
+
+			defaultStr, decls, err := formatDefaultValue(g.output, []string{typeName, f.Name}, f.Type, f.DefaultValue, out.MaxLineLength())
+			if err != nil {
+				return fmt.Errorf("cannot format default value for field %s: %w", f.Name, err)
+			}
+
+			auxDecls = append(auxDecls, decls...)
+			assignments = append(assignments, fieldAssignment{name: f.Name, value: defaultStr})
+		}
+
+		// Non-trivial defaults (e.g. parsed durations) are hoisted into
+		// package-level vars and initialized once, at package init time,
+		// instead of being recomputed on every New* call. addAuxDecls
+		// dedups across every constructor in the file, so only the decls
+		// not already emitted by an earlier type are written here.
+		for _, decl := range g.output.addAuxDecls(auxDecls) {
+			out.Printlnf("%s", decl)
+		}
+
 		out.Commentf("New%s creates a new %s with default values.", typeName, typeName)
 		out.Printlnf("func New%s() %s {", typeName, typeName)
 		out.Indent(1)
 		out.Printlnf("return %s{", typeName)
 		out.Indent(1)
 
-		for _, f := range st.Fields {
-			if f.DefaultValue != nil {
-				// Skip the AdditionalProperties field as it has special handling
-				if f.Name == additionalProperties {
-					continue
-				}
-
-				defaultStr, err := formatDefaultValue(f.Type, f.DefaultValue, out.MaxLineLength())
-				if err != nil {
-					return fmt.Errorf("cannot format default value for field %s: %w", f.Name, err)
-				}
-
-				out.Printlnf("%s: %s,", f.Name, defaultStr)
-			}
+		for _, a := range assignments {
+			out.Printlnf("%s: %s,", a.name, a.value)
 		}
 
 		out.Indent(-1)
@@ -70,59 +99,266 @@ func (g *constructorGenerator) generate() func(*codegen.Emitter) error {
 		out.Indent(-1)
 		out.Printlnf("}")
 
+		if g.output.ApplyDefaults {
+			g.output.addImport("reflect")
+			g.generateApplyDefaults(out, typeName, assignments)
+		}
+
 		return nil
 	}
 }
 
+// generateApplyDefaults emits an ApplyDefaults method that sets any
+// zero-valued fields to their schema defaults, leaving already-populated
+// fields untouched. This is useful for callers that decode partial JSON
+// into an existing value and want to fill in missing fields.
+func (g *constructorGenerator) generateApplyDefaults(
+	out *codegen.Emitter, typeName string, assignments []fieldAssignment,
+) {
+	receiver := strings.ToLower(typeName[:1])
+
+	out.EmptyLine()
+	out.Commentf(
+		"ApplyDefaults sets any zero-valued fields of %s to their default values.",
+		typeName,
+	)
+	out.Printlnf("func (%s *%s) ApplyDefaults() {", receiver, typeName)
+	out.Indent(1)
+
+	for _, a := range assignments {
+		out.Printlnf("if reflect.ValueOf(%s.%s).IsZero() {", receiver, a.name)
+		out.Indent(1)
+		out.Printlnf("%s.%s = %s", receiver, a.name, a.value)
+		out.Indent(-1)
+		out.Printlnf("}")
+	}
+
+	out.Indent(-1)
+	out.Printlnf("}")
+}
+
+// durationVarName returns the package-level variable name used to hold the
+// parsed default value for the field identified by path, e.g.
+// defaultConfig_Timeout for path {"Config", "Timeout"}.
+//
+// path segments are joined with "_" rather than concatenated directly, so
+// that two structurally different paths can never collide on the same
+// name: without a separator, a top-level type "ConfigRetry" with field
+// "Backoff" and a nested field "Retry" (path "Config","Retry") with field
+// "Backoff" both flatten to "ConfigRetryBackoff". Go identifiers generated
+// from schema names don't contain underscores, so joining on "_" keeps the
+// boundary between segments unambiguous.
+func durationVarName(path []string) string {
+	return "default" + strings.Join(path, "_")
+}
+
+// durationVarDecl returns the package-level var declaration that parses a
+// non-empty duration default once, at package init time, rather than on
+// every New* call.
+func durationVarDecl(path []string, defaultDurationISO8601 string) (string, error) {
+	d, err := duration.Parse(defaultDurationISO8601)
+	if err != nil {
+		return "", ErrCannotConvertISO8601ToGoFormat
+	}
+
+	goDurationStr := d.ToTimeDuration().String()
+
+	return fmt.Sprintf(
+		"var %s, _ = time.ParseDuration(%q)",
+		durationVarName(path), goDurationStr,
+	), nil
+}
+
 // formatDefaultValue formats a default value for use in generated Go code.
-func formatDefaultValue(fieldType codegen.Type, defaultValue interface{}, maxLineLen int32) (string, error) {
+// It returns the expression to assign to the field, plus any package-level
+// auxiliary var declarations (e.g. a parsed duration) that the expression
+// refers to and that the caller must emit once, at package scope.
+func formatDefaultValue(
+	o *output, path []string, fieldType codegen.Type, defaultValue interface{}, maxLineLen int32,
+) (string, []string, error) {
 	// Handle named types (nested structs with their own defaults)
 	if nt, ok := fieldType.(*codegen.NamedType); ok {
 		dvm, ok := defaultValue.(map[string]any)
 		if ok {
-			namedFields := ""
+			return formatNamedTypeValue(o, path, nt, dvm, maxLineLen)
+		}
+	}
 
-			for _, k := range sortedKeys(dvm) {
-				namedFields += fmt.Sprintf("\n%s: %s,", upperFirst(k), litter.Sdump(dvm[k]))
+	// Handle duration type. Nullable mirrors the field's actual Go type
+	// (see codegen.DurationType.Generate): a Nullable field is
+	// types.OptionalDuration, whose zero value means "use the default", so
+	// a default of JSON null or "" needs no parsing at all. A non-nullable
+	// field is a bare time.Duration, which has no way to represent "unset"
+	// distinct from zero, so a null/"" default there is a schema error.
+	// Either way, a non-empty default is parsed once into the
+	// package-level var emitted by durationVarDecl, not on every New* call.
+	if dt, ok := fieldType.(codegen.DurationType); ok {
+		if defaultValue == nil || defaultValue == "" {
+			if !dt.Nullable {
+				return "", nil, ErrDurationIsEmpty
 			}
 
-			if namedFields != "" {
-				namedFields += "\n"
-			}
+			o.addImport(typesImportPath)
 
-			return fmt.Sprintf("%s{%s}", nt.Decl.GetName(), namedFields), nil
+			return "types.OptionalDuration{}", nil, nil
 		}
-	}
 
-	// Handle duration type
-	if _, ok := fieldType.(codegen.DurationType); ok {
 		defaultDurationISO8601, ok := defaultValue.(string)
 		if !ok {
-			return "", fmt.Errorf("%w: %T given", ErrDefaultDurationIsNotAString, defaultValue)
+			return "", nil, fmt.Errorf("%w: %T given", ErrDefaultDurationIsNotAString, defaultValue)
 		}
 
-		if defaultDurationISO8601 == "" {
-			return "", ErrDurationIsEmpty
+		decl, err := durationVarDecl(path, defaultDurationISO8601)
+		if err != nil {
+			return "", nil, err
 		}
 
-		d, err := duration.Parse(defaultDurationISO8601)
-		if err != nil {
-			return "", ErrCannotConvertISO8601ToGoFormat
+		// The hoisted var calls time.ParseDuration; for a non-nullable
+		// field this import is also pulled in by the field's own
+		// time.Duration type, but for a Nullable field (types.OptionalDuration)
+		// nothing else references it, so it must be registered here.
+		o.addImport("time")
+
+		varRef := durationVarName(path)
+		if dt.Nullable {
+			o.addImport(typesImportPath)
+
+			varRef = fmt.Sprintf("types.NewOptionalDuration(%s)", varRef)
 		}
 
-		goDurationStr := d.ToTimeDuration().String()
-		// For constructors, we use a constant duration value parsed at init time
-		// This is simpler than the validator approach since we're just initializing
-		return fmt.Sprintf("func() time.Duration { d, _ := time.ParseDuration(%q); return d }()", goDurationStr), nil
+		return varRef, []string{decl}, nil
 	}
 
 	// Handle slice types
 	if err := tryFormatSlice(defaultValue); err == nil {
-		return formatSliceValue(fieldType, defaultValue, maxLineLen)
+		value, err := formatSliceValue(fieldType, defaultValue, maxLineLen)
+
+		return value, nil, err
+	}
+
+	// Handle map types (the NamedType case above already handles defaults
+	// for map[string]any values targeting a nested struct's own fields)
+	if _, ok := defaultValue.(map[string]any); ok {
+		return formatMapValue(o, path, fieldType, defaultValue, maxLineLen)
 	}
 
 	// Fallback to litter.Sdump
-	return strings.TrimSpace(litter.Sdump(defaultValue)), nil
+	return strings.TrimSpace(litter.Sdump(defaultValue)), nil, nil
+}
+
+// formatNamedTypeValue formats a nested struct's default value. It recurses
+// through formatDefaultValue for every entry so that nested durations,
+// slices, and maps are formatted the same way as top-level fields, and it
+// fails fast if dvm references a field the struct doesn't have, or omits a
+// required field that itself declares a default. A field that the schema
+// doesn't mark required may be omitted from dvm; it is then left out of the
+// emitted literal and takes its Go zero value, not its own default.
+//
+// path identifies the outer field this nested value is being formatted
+// for (e.g. {"Config", "Retry"}), not just the nested struct's type name,
+// so that two outer fields sharing the same nested struct type don't
+// collide on the same hoisted aux var name.
+func formatNamedTypeValue(
+	o *output, path []string, nt *codegen.NamedType, dvm map[string]any, maxLineLen int32,
+) (string, []string, error) {
+	nestedTypeName := nt.Decl.GetName()
+
+	nst, ok := nt.Decl.Type.(*codegen.StructType)
+	if !ok {
+		return "", nil, fmt.Errorf("%w: %s is not a struct type", ErrInvalidDefaultValue, nestedTypeName)
+	}
+
+	fieldsByName := make(map[string]*codegen.StructField, len(nst.Fields))
+	for i := range nst.Fields {
+		fieldsByName[nst.Fields[i].Name] = &nst.Fields[i]
+	}
+
+	var (
+		auxDecls []string
+		seen     = make(map[string]bool, len(dvm))
+	)
+
+	namedFields := ""
+
+	for _, k := range sortedKeys(dvm) {
+		fieldName := upperFirst(k)
+
+		f, ok := fieldsByName[fieldName]
+		if !ok {
+			return "", nil, fmt.Errorf("%w: %s has no field %q", ErrUnknownNamedTypeDefaultKey, nestedTypeName, fieldName)
+		}
+
+		valueStr, decls, err := formatDefaultValue(o, append(path, f.Name), f.Type, dvm[k], maxLineLen)
+		if err != nil {
+			return "", nil, fmt.Errorf("cannot format default value for %s.%s: %w", nestedTypeName, f.Name, err)
+		}
+
+		auxDecls = append(auxDecls, decls...)
+		seen[fieldName] = true
+		namedFields += fmt.Sprintf("\n%s: %s,", f.Name, valueStr)
+	}
+
+	for _, f := range nst.Fields {
+		if f.Name == additionalProperties {
+			continue
+		}
+
+		if f.Required && f.DefaultValue != nil && !seen[f.Name] {
+			return "", nil, fmt.Errorf("%w: %s.%s", ErrMissingRequiredDefaultField, nestedTypeName, f.Name)
+		}
+	}
+
+	if namedFields != "" {
+		namedFields += "\n"
+	}
+
+	return fmt.Sprintf("%s{%s}", nestedTypeName, namedFields), auxDecls, nil
+}
+
+// formatMapValue formats a map[string]T default value (e.g. an
+// additionalProperties map) as a Go composite literal, recursing through
+// formatDefaultValue for each element so that nested durations, slices, and
+// named-struct defaults are formatted consistently with top-level fields.
+func formatMapValue(
+	o *output, path []string, fieldType codegen.Type, defaultValue interface{}, maxLineLen int32,
+) (string, []string, error) {
+	dvm, ok := defaultValue.(map[string]any)
+	if !ok {
+		return "", nil, ErrInvalidDefaultValue
+	}
+
+	mt, ok := fieldType.(*codegen.MapType)
+	if !ok {
+		return strings.TrimSpace(litter.Sdump(defaultValue)), nil, nil
+	}
+
+	tmpEmitter := codegen.NewEmitter(maxLineLen)
+
+	if err := fieldType.Generate(tmpEmitter); err != nil {
+		return "", nil, fmt.Errorf("%w: %w", ErrCannotDumpDefaultMap, err)
+	}
+
+	if len(dvm) == 0 {
+		return tmpEmitter.String() + "{}", nil, nil
+	}
+
+	var auxDecls []string
+
+	tmpEmitter.Printlnf("{")
+
+	for _, k := range sortedKeys(dvm) {
+		valueStr, decls, err := formatDefaultValue(o, append(path, upperFirst(k)), mt.ValueType, dvm[k], maxLineLen)
+		if err != nil {
+			return "", nil, fmt.Errorf("cannot format default value for map key %q: %w", k, err)
+		}
+
+		auxDecls = append(auxDecls, decls...)
+		tmpEmitter.Printlnf("%q: %s,", k, valueStr)
+	}
+
+	tmpEmitter.Printf("}")
+
+	return tmpEmitter.String(), auxDecls, nil
 }
 
 // tryFormatSlice checks if the value can be formatted as a slice.
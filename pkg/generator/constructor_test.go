@@ -0,0 +1,276 @@
+package generator
+
+import (
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/atombender/go-jsonschema/pkg/codegen"
+)
+
+func TestFormatDefaultValue_Duration(t *testing.T) {
+	t.Parallel()
+
+	t.Run("non-nullable with a value hoists a package var", func(t *testing.T) {
+		t.Parallel()
+
+		o := newOutput(false)
+
+		value, decls, err := formatDefaultValue(
+			o, []string{"Config", "Timeout"}, codegen.DurationType{}, "PT1M30S", 120,
+		)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if value != "defaultConfig_Timeout" {
+			t.Errorf("value = %q, want reference to the hoisted var", value)
+		}
+
+		if len(decls) != 1 {
+			t.Fatalf("decls = %v, want exactly one aux decl", decls)
+		}
+
+		assertHasImport(t, o, "time")
+	})
+
+	t.Run("non-nullable with an empty default errors", func(t *testing.T) {
+		t.Parallel()
+
+		if _, _, err := formatDefaultValue(
+			newOutput(false), []string{"Config", "Timeout"}, codegen.DurationType{}, "", 120,
+		); err != ErrDurationIsEmpty {
+			t.Errorf("err = %v, want ErrDurationIsEmpty", err)
+		}
+	})
+
+	t.Run("nullable with a null default is the zero OptionalDuration", func(t *testing.T) {
+		t.Parallel()
+
+		o := newOutput(false)
+
+		value, decls, err := formatDefaultValue(
+			o, []string{"Config", "Timeout"}, codegen.DurationType{Nullable: true}, nil, 120,
+		)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if value != "types.OptionalDuration{}" || decls != nil {
+			t.Errorf("got (%q, %v), want (\"types.OptionalDuration{}\", nil)", value, decls)
+		}
+
+		assertHasImport(t, o, typesImportPath)
+	})
+
+	t.Run("nullable with a value wraps the hoisted var and imports time", func(t *testing.T) {
+		t.Parallel()
+
+		o := newOutput(false)
+
+		value, decls, err := formatDefaultValue(
+			o, []string{"Config", "Timeout"}, codegen.DurationType{Nullable: true}, "PT1M", 120,
+		)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if value != "types.NewOptionalDuration(defaultConfig_Timeout)" {
+			t.Errorf("value = %q", value)
+		}
+
+		if len(decls) != 1 {
+			t.Fatalf("decls = %v, want exactly one aux decl", decls)
+		}
+
+		// Unlike the non-nullable case, nothing else in the generated field
+		// type (types.OptionalDuration) references time, so the hoisted
+		// var's time.ParseDuration call is the only reason to import it.
+		assertHasImport(t, o, "time")
+		assertHasImport(t, o, typesImportPath)
+	})
+}
+
+// TestDurationVarName_NoCollisionAcrossPaths checks that a top-level field
+// and a differently-shaped nested field path that would flatten to the
+// same string under naive concatenation produce distinct var names.
+func TestDurationVarName_NoCollisionAcrossPaths(t *testing.T) {
+	t.Parallel()
+
+	direct := durationVarName([]string{"ConfigRetry", "Backoff"})
+	nested := durationVarName([]string{"Config", "Retry", "Backoff"})
+
+	if direct == nested {
+		t.Errorf("durationVarName collided: %q == %q", direct, nested)
+	}
+}
+
+// TestGenerate_ProducesSyntacticallyValidGo builds a constructorGenerator
+// with ApplyDefaults enabled and checks that the emitted New*/ApplyDefaults
+// code parses as valid Go source and that the generator records the extra
+// imports that code needs.
+func TestGenerate_ProducesSyntacticallyValidGo(t *testing.T) {
+	t.Parallel()
+
+	decl := &codegen.TypeDecl{
+		Name: "Config",
+		Type: &codegen.StructType{
+			Fields: []codegen.StructField{
+				{Name: "Timeout", Type: codegen.DurationType{}, DefaultValue: "PT1M30S"},
+			},
+		},
+	}
+
+	o := newOutput(true)
+	g := &constructorGenerator{decl: decl, output: o}
+
+	out := codegen.NewEmitter(120)
+	if err := g.generate()(out); err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+
+	assertHasImport(t, o, "reflect")
+	assertHasImport(t, o, "time")
+
+	src := "package generated\n\nimport (\n\t\"reflect\"\n\t\"time\"\n)\n\n" + out.String()
+
+	if _, err := parser.ParseFile(token.NewFileSet(), "generated.go", src, parser.AllErrors); err != nil {
+		t.Fatalf("generated code does not parse: %v\n---\n%s", err, src)
+	}
+}
+
+// TestDurationVarDecl_ParsesOnce checks that the emitted declaration is a
+// single package-level var statement, not a closure re-evaluated on every
+// constructor call.
+func TestDurationVarDecl_ParsesOnce(t *testing.T) {
+	t.Parallel()
+
+	decl, err := durationVarDecl([]string{"Config", "Timeout"}, "PT1M30S")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if strings.Contains(decl, "func()") {
+		t.Errorf("decl = %q, want a plain var decl, not a per-call closure", decl)
+	}
+
+	if got, want := strings.Count(decl, "time.ParseDuration("), 1; got != want {
+		t.Errorf("decl calls time.ParseDuration %d times, want %d", got, want)
+	}
+}
+
+// TestOutput_AddAuxDecls_DedupsAcrossConstructors verifies that when two
+// constructors in the same generated file hoist the same default, the var
+// is emitted (and therefore parsed) exactly once.
+func TestOutput_AddAuxDecls_DedupsAcrossConstructors(t *testing.T) {
+	t.Parallel()
+
+	out := newOutput(false)
+
+	decl := `var defaultConfig_Timeout, _ = time.ParseDuration("1m30s")`
+
+	first := out.addAuxDecls([]string{decl})
+	if len(first) != 1 {
+		t.Fatalf("first addAuxDecls = %v, want the decl to be fresh", first)
+	}
+
+	second := out.addAuxDecls([]string{decl})
+	if len(second) != 0 {
+		t.Fatalf("second addAuxDecls = %v, want no decls (already emitted)", second)
+	}
+}
+
+// assertHasImport fails the test unless o has recorded path via addImport.
+func assertHasImport(t *testing.T, o *output, path string) {
+	t.Helper()
+
+	for _, p := range o.extraImports() {
+		if p == path {
+			return
+		}
+	}
+
+	t.Errorf("extraImports() = %v, want it to include %q", o.extraImports(), path)
+}
+
+// stringSliceType is a minimal codegen.Type fixture standing in for the
+// real generated []string type, since the slice-typed codegen nodes this
+// stripped tree would otherwise use aren't part of this package.
+type stringSliceType struct{}
+
+func (stringSliceType) Generate(out *codegen.Emitter) error {
+	out.Printf("[]string")
+
+	return nil
+}
+
+// TestFormatNamedTypeValue_Nested covers a nested struct default that mixes
+// a duration field and a slice field, and checks that an omitted
+// non-required field with its own default is allowed while an omitted
+// required one is rejected.
+func TestFormatNamedTypeValue_Nested(t *testing.T) {
+	t.Parallel()
+
+	retryDecl := &codegen.TypeDecl{
+		Name: "Retry",
+		Type: &codegen.StructType{
+			Fields: []codegen.StructField{
+				{Name: "Backoff", Type: codegen.DurationType{}, Required: true, DefaultValue: "PT1S"},
+				{Name: "Codes", Type: stringSliceType{}, DefaultValue: []interface{}{"500", "503"}},
+			},
+		},
+	}
+
+	nt := &codegen.NamedType{Decl: retryDecl}
+
+	t.Run("omitting a non-required defaulted field is fine", func(t *testing.T) {
+		t.Parallel()
+
+		value, decls, err := formatNamedTypeValue(newOutput(false), []string{"Config", "Retry"}, nt, map[string]any{
+			"backoff": "PT2S",
+		}, 120)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !strings.Contains(value, "Backoff:") {
+			t.Errorf("value = %q, want a Backoff assignment", value)
+		}
+
+		if strings.Contains(value, "Codes:") {
+			t.Errorf("value = %q, want no Codes assignment since it was omitted", value)
+		}
+
+		if len(decls) != 1 {
+			t.Fatalf("decls = %v, want exactly one hoisted duration decl", decls)
+		}
+	})
+
+	t.Run("omitting a required defaulted field errors", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := formatNamedTypeValue(newOutput(false), []string{"Config", "Retry"}, nt, map[string]any{
+			"codes": []interface{}{"429"},
+		}, 120)
+		if err == nil {
+			t.Fatal("expected an error for the omitted required field")
+		}
+	})
+
+	t.Run("both fields supplied formats durations and slices together", func(t *testing.T) {
+		t.Parallel()
+
+		value, _, err := formatNamedTypeValue(newOutput(false), []string{"Config", "Retry"}, nt, map[string]any{
+			"backoff": "PT3S",
+			"codes":   []interface{}{"429", "503"},
+		}, 120)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !strings.Contains(value, "Backoff:") || !strings.Contains(value, "Codes:") {
+			t.Errorf("value = %q, want both Backoff and Codes assignments", value)
+		}
+	})
+}
@@ -0,0 +1,15 @@
+package generator
+
+import "errors"
+
+var (
+	ErrDefaultDurationIsNotAString    = errors.New("default duration value is not a string")
+	ErrDurationIsEmpty                = errors.New("default duration value is empty")
+	ErrCannotConvertISO8601ToGoFormat = errors.New("cannot convert ISO 8601 duration to a Go duration")
+	ErrCannotFindSlideToDump          = errors.New("default value is not a slice")
+	ErrInvalidDefaultValue            = errors.New("invalid default value")
+	ErrCannotDumpDefaultSlice         = errors.New("cannot format default slice value")
+	ErrCannotDumpDefaultMap           = errors.New("cannot format default map value")
+	ErrUnknownNamedTypeDefaultKey     = errors.New("default value references an unknown field")
+	ErrMissingRequiredDefaultField    = errors.New("default value is missing a required field")
+)
@@ -0,0 +1,37 @@
+package generator
+
+import (
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+// additionalProperties is the generated field name used for a struct's
+// additionalProperties map, which constructors and default-value
+// formatting special-case rather than addressing by JSON key.
+const additionalProperties = "AdditionalProperties"
+
+// sortedKeys returns the keys of m sorted alphabetically, so that
+// map-typed default values are emitted deterministically.
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// upperFirst returns s with its first rune upper-cased, matching the
+// exported Go field name generated for a JSON schema property.
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+
+	r, size := utf8.DecodeRuneInString(s)
+
+	return string(unicode.ToUpper(r)) + s[size:]
+}
@@ -0,0 +1,69 @@
+package generator
+
+import "sort"
+
+// output aggregates state shared across every type declaration generated
+// into a single output file: auxiliary package-level declarations hoisted
+// out of individual constructors (see constructorGenerator.generate), the
+// extra imports those declarations need, and the file-level generator
+// options that apply to every type in the file.
+type output struct {
+	// ApplyDefaults is set through newOutput; there is no separate
+	// generator config option for it. When true, constructorGenerator
+	// also emits an ApplyDefaults method
+	// alongside New* so that callers decoding partial JSON into an
+	// existing value can fill in missing fields without discarding ones
+	// already set.
+	ApplyDefaults bool
+
+	auxDecls map[string]bool
+	imports  map[string]bool
+}
+
+// newOutput returns an empty output configured with the given generator options.
+func newOutput(applyDefaults bool) *output {
+	return &output{
+		ApplyDefaults: applyDefaults,
+		auxDecls:      map[string]bool{},
+		imports:       map[string]bool{},
+	}
+}
+
+// addAuxDecls records decls to be emitted once, at package scope, and
+// returns only the ones not already recorded, so that two constructors in
+// the same file that happen to hoist an identical declaration don't
+// redeclare the same var twice.
+func (o *output) addAuxDecls(decls []string) []string {
+	var fresh []string
+
+	for _, d := range decls {
+		if o.auxDecls[d] {
+			continue
+		}
+
+		o.auxDecls[d] = true
+
+		fresh = append(fresh, d)
+	}
+
+	return fresh
+}
+
+// addImport records an import path the generated file needs in addition
+// to its base set, such as "reflect" for ApplyDefaults or the runtime
+// types package for OptionalDuration. Repeats are deduplicated.
+func (o *output) addImport(path string) {
+	o.imports[path] = true
+}
+
+// extraImports returns the import paths recorded via addImport, sorted.
+func (o *output) extraImports() []string {
+	paths := make([]string, 0, len(o.imports))
+	for p := range o.imports {
+		paths = append(paths, p)
+	}
+
+	sort.Strings(paths)
+
+	return paths
+}
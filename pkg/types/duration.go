@@ -0,0 +1,63 @@
+// Package types provides small runtime helper types used by generated
+// code. They have no dependency on the generator itself and can be
+// imported directly by consumers of generated structs.
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// OptionalDuration is a time.Duration that distinguishes "unset" from the
+// zero duration. Its zero value means "use the default": Set is false and
+// Value is 0. It round-trips JSON null and the empty string to the unset
+// state, and any other valid Go duration string to a set value.
+type OptionalDuration struct {
+	Value time.Duration
+	Set   bool
+}
+
+// NewOptionalDuration returns an OptionalDuration with Value set to d.
+func NewOptionalDuration(d time.Duration) OptionalDuration {
+	return OptionalDuration{Value: d, Set: true}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o OptionalDuration) MarshalJSON() ([]byte, error) {
+	if !o.Set {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(o.Value.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Both JSON null and the empty
+// string unmarshal to the unset state.
+func (o *OptionalDuration) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*o = OptionalDuration{}
+
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	if s == "" {
+		*o = OptionalDuration{}
+
+		return nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+
+	*o = NewOptionalDuration(d)
+
+	return nil
+}